@@ -0,0 +1,3 @@
+module github.com/na4ma4/go-contextual
+
+go 1.21
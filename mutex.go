@@ -0,0 +1,75 @@
+package contextual
+
+import (
+	"context"
+	"sync"
+)
+
+// Mutex is a mutual exclusion lock whose acquisition can be abandoned when a
+// context is cancelled.
+//
+// The zero value is an unlocked Mutex. To support this the backing channel is
+// created lazily, so every call pays for a sync.Once check. A Mutex must not
+// be copied after first use.
+type Mutex struct {
+	once sync.Once
+	ch   chan struct{}
+}
+
+// NewMutex returns a new unlocked Mutex.
+func NewMutex() *Mutex {
+	return &Mutex{}
+}
+
+// init creates the channel backing the lock on first use.
+func (m *Mutex) init() {
+	m.once.Do(func() {
+		m.ch = make(chan struct{}, 1)
+	})
+}
+
+// Lock acquires the lock, blocking until it is available or ctx is done.
+//
+// If ctx is done before the lock is acquired the lock is not held and the
+// context's error is returned.
+func (m *Mutex) Lock(ctx context.Context) error {
+	m.init()
+
+	// Prefer a context that is already done over a free lock.
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	select {
+	case m.ch <- struct{}{}:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// TryLock tries to acquire the lock without blocking and reports whether it
+// succeeded.
+func (m *Mutex) TryLock() bool {
+	m.init()
+
+	select {
+	case m.ch <- struct{}{}:
+		return true
+	default:
+		return false
+	}
+}
+
+// Unlock releases the lock.
+//
+// It panics if the lock is not held.
+func (m *Mutex) Unlock() {
+	m.init()
+
+	select {
+	case <-m.ch:
+	default:
+		panic("contextual: unlock of unlocked Mutex")
+	}
+}
@@ -0,0 +1,114 @@
+package contextual
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestMutexLockTimesOutWhileHeld(t *testing.T) {
+	m := NewMutex()
+
+	if err := m.Lock(context.Background()); err != nil {
+		t.Fatalf("Lock(): got error '%s', want nil", err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+
+	if err := m.Lock(ctx); !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("Lock(): got error '%v', want '%s'", err, context.DeadlineExceeded)
+	}
+}
+
+func TestMutexLockSucceedsAfterUnlock(t *testing.T) {
+	m := NewMutex()
+
+	if err := m.Lock(context.Background()); err != nil {
+		t.Fatalf("Lock(): got error '%s', want nil", err)
+	}
+
+	errChan := make(chan error, 1)
+	go func() {
+		errChan <- m.Lock(context.Background())
+	}()
+
+	select {
+	case err := <-errChan:
+		t.Fatalf("Lock(): returned '%v' while lock held, want it to block", err)
+	case <-time.After(20 * time.Millisecond):
+	}
+
+	m.Unlock()
+
+	select {
+	case err := <-errChan:
+		if err != nil {
+			t.Errorf("Lock(): got error '%s', want nil", err)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("Lock(): did not acquire lock after Unlock()")
+	}
+}
+
+func TestMutexLockCancelledContext(t *testing.T) {
+	m := NewMutex()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	if err := m.Lock(ctx); !errors.Is(err, context.Canceled) {
+		t.Errorf("Lock(): got error '%v', want '%s'", err, context.Canceled)
+	}
+
+	if !m.TryLock() {
+		t.Error("TryLock(): got false, want true (cancelled Lock must not hold the lock)")
+	}
+}
+
+func TestMutexTryLock(t *testing.T) {
+	m := NewMutex()
+
+	if err := m.Lock(context.Background()); err != nil {
+		t.Fatalf("Lock(): got error '%s', want nil", err)
+	}
+
+	if m.TryLock() {
+		t.Error("TryLock(): got true while lock held, want false")
+	}
+
+	m.Unlock()
+
+	if !m.TryLock() {
+		t.Error("TryLock(): got false after Unlock(), want true")
+	}
+}
+
+func TestMutexUnlockUnlockedPanics(t *testing.T) {
+	m := NewMutex()
+
+	defer func() {
+		if r := recover(); r == nil {
+			t.Error("Unlock(): expected panic on unlocked Mutex")
+		}
+	}()
+
+	m.Unlock()
+}
+
+func TestMutexZeroValue(t *testing.T) {
+	var m Mutex
+
+	if !m.TryLock() {
+		t.Fatal("TryLock(): got false on zero value, want true")
+	}
+
+	m.Unlock()
+
+	if err := m.Lock(context.Background()); err != nil {
+		t.Errorf("Lock(): got error '%s', want nil", err)
+	}
+
+	m.Unlock()
+}